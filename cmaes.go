@@ -0,0 +1,156 @@
+package gago
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"sort"
+)
+
+// A Polisher locally refines a solution once an Optimizer has terminated,
+// trading its global exploration strength for fast local convergence near
+// the optimum.
+type Polisher interface {
+	Polish(best Individual, makeGenome GenomeMaker, rng *rand.Rand) Individual
+	Validate() error
+}
+
+// CMAES runs Covariance Matrix Adaptation Evolution Strategy to locally
+// refine a solution. It maintains a diagonal (separable) covariance matrix
+// rather than a full one; this sep-CMA-ES simplification forgoes modelling
+// correlations between genes in exchange for not needing an external linear
+// algebra dependency. The per-dimension variances are adapted with a rank-mu
+// update from the selected samples only; there is no rank-one update. The
+// global step size Sigma is adapted from the cumulative evolution path.
+type CMAES struct {
+	Lambda int     // Samples per iteration; defaults to 4+floor(3*ln(n)) when zero
+	Sigma  float64 // Initial step size
+	NIters int     // Iteration budget; when zero, runs until Tol, or 100*n iterations if Tol is also zero
+	Tol    float64 // Stop once Sigma drops below Tol
+}
+
+// Validate the parameters of a CMAES to ensure it will run correctly. A
+// zero Sigma would make every sampled candidate equal to mean, burning the
+// whole iteration budget on a no-op instead of refining anything, and a
+// Lambda under 2 leaves mu at 0, dividing muEff by zero.
+func (cma CMAES) Validate() error {
+	if cma.Sigma <= 0 {
+		return errors.New("'Sigma' should be strictly higher than 0")
+	}
+	if cma.Lambda != 0 && cma.Lambda < 2 {
+		return errors.New("'Lambda' should be higher or equal to 2")
+	}
+	return nil
+}
+
+// Polish samples Lambda candidates around best on every iteration, evaluates
+// them with scratch genomes produced by makeGenome, keeps the fittest half
+// to recompute the mean, and adapts Sigma and the diagonal covariance from
+// their spread before repeating. It returns the fittest individual ever
+// sampled, including best itself if no candidate improved on it.
+func (cma CMAES) Polish(best Individual, makeGenome GenomeMaker, rng *rand.Rand) Individual {
+	var (
+		mean   = append([]float64{}, best.Genome.CastFloat()...)
+		n      = len(mean)
+		lambda = cma.Lambda
+	)
+	if lambda == 0 {
+		lambda = 4 + int(3*math.Log(float64(n)))
+	}
+	var (
+		mu      = lambda / 2
+		weights = make([]float64, mu)
+		wSum    float64
+	)
+	for i := range weights {
+		weights[i] = math.Log(float64(mu)+1) - math.Log(float64(i)+1)
+		wSum += weights[i]
+	}
+	var muEff float64
+	for i := range weights {
+		weights[i] /= wSum
+		muEff += weights[i] * weights[i]
+	}
+	muEff = 1 / muEff
+	var (
+		c       = make([]float64, n) // Diagonal covariance
+		cCov    = 1 / float64(n)
+		cs      = (muEff + 2) / (float64(n) + muEff + 5)
+		damps   = 1 + cs
+		expectN = math.Sqrt(float64(n)) * (1 - 1/(4*float64(n)) + 1/(21*float64(n)*float64(n)))
+		pSigma  = make([]float64, n)
+		sigma   = cma.Sigma
+	)
+	for i := range c {
+		c[i] = 1
+	}
+
+	type sample struct {
+		z       []float64
+		genome  Genome
+		fitness float64
+	}
+
+	// A zero NIters means "run until Tol", but a zero-value CMAES{} has both
+	// NIters and Tol at zero, which would never terminate; fall back to a
+	// fixed iteration budget in that case.
+	var maxIters = cma.NIters
+	if maxIters == 0 && cma.Tol <= 0 {
+		maxIters = 100 * n
+		if maxIters == 0 {
+			maxIters = 100
+		}
+	}
+	for iter := 0; maxIters == 0 || iter < maxIters; iter++ {
+		if cma.Tol > 0 && sigma < cma.Tol {
+			break
+		}
+		var samples = make([]sample, lambda)
+		for k := range samples {
+			var (
+				genome = makeGenome(rng)
+				x      = genome.CastFloat()
+				z      = make([]float64, n)
+			)
+			for d := range x {
+				z[d] = rng.NormFloat64()
+				x[d] = mean[d] + sigma*math.Sqrt(c[d])*z[d]
+			}
+			samples[k] = sample{z: z, genome: genome, fitness: genome.Evaluate()}
+		}
+		sort.Slice(samples, func(i, j int) bool { return samples[i].fitness < samples[j].fitness })
+		if samples[0].fitness < best.Fitness {
+			best = Individual{Genome: samples[0].genome, Fitness: samples[0].fitness}
+		}
+		// Recompute the mean as the weighted average of the mu fittest samples
+		var (
+			newMean = make([]float64, n)
+			zMean   = make([]float64, n)
+		)
+		for i := 0; i < mu; i++ {
+			var x = samples[i].genome.CastFloat()
+			for d := 0; d < n; d++ {
+				newMean[d] += weights[i] * x[d]
+				zMean[d] += weights[i] * samples[i].z[d]
+			}
+		}
+		mean = newMean
+		// Update the evolution path and adapt the step size from its length
+		var pSigmaNorm float64
+		for d := range pSigma {
+			pSigma[d] = (1-cs)*pSigma[d] + math.Sqrt(cs*(2-cs)*muEff)*zMean[d]
+			pSigmaNorm += pSigma[d] * pSigma[d]
+		}
+		pSigmaNorm = math.Sqrt(pSigmaNorm)
+		sigma *= math.Exp((cs / damps) * (pSigmaNorm/expectN - 1))
+		// Rank-mu update of the diagonal covariance matrix
+		for d := range c {
+			var rankMu float64
+			for i := 0; i < mu; i++ {
+				rankMu += weights[i] * samples[i].z[d] * samples[i].z[d]
+			}
+			c[d] = (1-cCov)*c[d] + cCov*rankMu
+		}
+	}
+	return best
+}
@@ -0,0 +1,59 @@
+package gago
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+	"time"
+)
+
+func TestCMAESValidate(t *testing.T) {
+	if err := (CMAES{Sigma: 0}).Validate(); err == nil {
+		t.Error("expected an error for a non-positive Sigma")
+	}
+	if err := (CMAES{Sigma: 1, Lambda: 1}).Validate(); err == nil {
+		t.Error("expected an error for a Lambda below 2")
+	}
+	if err := (CMAES{Sigma: 1}).Validate(); err != nil {
+		t.Errorf("did not expect an error, got %v", err)
+	}
+	if err := (CMAES{Sigma: 1, Lambda: 4}).Validate(); err != nil {
+		t.Errorf("did not expect an error, got %v", err)
+	}
+}
+
+func TestCMAESPolishSingleGeneDoesNotNaN(t *testing.T) {
+	var (
+		source    = rand.NewSource(time.Now().UnixNano())
+		generator = rand.New(source)
+		maker     = makeFloatGenomeMaker(1)
+		best      = MakeIndividual(maker(generator))
+		cma       = CMAES{Sigma: 0.5, NIters: 10}
+	)
+	best.Fitness = best.Genome.Evaluate()
+	var polished = cma.Polish(best, maker, generator)
+	if math.IsNaN(polished.Fitness) {
+		t.Error("Polish produced a NaN fitness for a single-gene genome")
+	}
+	if polished.Fitness > best.Fitness {
+		t.Errorf("expected Polish to not regress, went from %v to %v", best.Fitness, polished.Fitness)
+	}
+}
+
+func TestCMAESPolishDoesNotRegress(t *testing.T) {
+	var (
+		source    = rand.NewSource(time.Now().UnixNano())
+		generator = rand.New(source)
+		maker     = makeFloatGenomeMaker(4)
+		best      = MakeIndividual(maker(generator))
+		cma       = CMAES{Sigma: 0.5, NIters: 20}
+	)
+	best.Fitness = best.Genome.Evaluate()
+	var polished = cma.Polish(best, maker, generator)
+	if math.IsNaN(polished.Fitness) {
+		t.Error("Polish produced a NaN fitness")
+	}
+	if polished.Fitness > best.Fitness {
+		t.Errorf("expected Polish to not regress, went from %v to %v", best.Fitness, polished.Fitness)
+	}
+}
@@ -0,0 +1,157 @@
+package gago
+
+import (
+	"errors"
+	"log"
+	"math/rand"
+)
+
+// minDEIndividuals is the smallest population size DE/rand/1/bin can mutate
+// with: a, b and c must each be distinct from the target and from one
+// another, which takes four individuals in total.
+const minDEIndividuals = 4
+
+// DiffEvo implements classical differential evolution (DE/rand/1/bin). For
+// each target vector it samples three other distinct vectors a, b, c, forms
+// a mutant v = a + F*(b-c), performs a binomial crossover with the target
+// under rate CR, and keeps whichever of the two is fitter. It operates on
+// float vectors via the same CastFloat method used by the GA's genomes, and
+// it reuses the same Genome/Individual/Population machinery as GA, so it
+// benefits from the same ParallelEval, Migrator, and Logger infrastructure.
+type DiffEvo struct {
+	// Fields that are provided by the user
+	MakeGenome   GenomeMaker
+	Topology     Topology
+	F            float64 // Differential weight
+	CR           float64 // Crossover rate
+	Migrator     Migrator
+	MigFrequency int
+	ParallelEval bool
+	HofSize      int
+	Logger       *log.Logger
+
+	// Fields that are generated at runtime
+	Populations Populations
+	HallOfFame  Individuals
+	Generations int
+	rng         *rand.Rand
+}
+
+// Validate the parameters of a DiffEvo to ensure it will run correctly.
+func (de DiffEvo) Validate() error {
+	if de.MakeGenome == nil {
+		return errors.New("'GenomeMaker' cannot be nil")
+	}
+	if err := de.Topology.Validate(); err != nil {
+		return err
+	}
+	if de.Topology.NIndividuals < minDEIndividuals {
+		return errors.New("'NIndividuals' should be higher or equal to 4 for DiffEvo")
+	}
+	if de.Migrator != nil && de.MigFrequency < 1 {
+		return errors.New("'MigFrequency' should be strictly higher than 0")
+	}
+	if de.HofSize < 1 {
+		return errors.New("'HofSize' should be higher or equal to 1")
+	}
+	return nil
+}
+
+// Initialize generates each population and evaluates its individuals.
+func (de *DiffEvo) Initialize() {
+	de.Populations = make(Populations, de.Topology.NPopulations)
+	de.rng = makeRandomNumberGenerator()
+	for i := range de.Populations {
+		de.Populations[i] = makePopulation(de.Topology.NIndividuals, de.MakeGenome, i)
+		de.Populations[i].Individuals.Evaluate()
+		de.Populations[i].Individuals.Sort()
+	}
+	de.HallOfFame = make(Individuals, 0, de.HofSize)
+	for _, pop := range de.Populations {
+		de.HallOfFame = mergeHallOfFame(de.HallOfFame, de.HofSize, pop)
+	}
+}
+
+// Enhance produces one DE/rand/1/bin trial vector per individual in every
+// population, evaluates the trials, and keeps whichever of the trial or the
+// original individual is fitter.
+func (de *DiffEvo) Enhance() {
+	de.Generations++
+	if de.Topology.NPopulations > 1 && de.Migrator != nil && de.Generations%de.MigFrequency == 0 {
+		de.Migrator.Apply(de.Populations, de.rng)
+	}
+	for p := range de.Populations {
+		var (
+			pop    = de.Populations[p]
+			trials = make(Individuals, len(pop.Individuals))
+		)
+		for i := range pop.Individuals {
+			trials[i] = de.mutate(i, pop.Individuals)
+		}
+		if de.ParallelEval {
+			trials.EvaluateParallel()
+		} else {
+			trials.Evaluate()
+		}
+		for i, trial := range trials {
+			if trial.Fitness < pop.Individuals[i].Fitness {
+				Recycle(pop.Individuals[i].Genome)
+				pop.Individuals[i] = trial
+			} else {
+				Recycle(trial.Genome)
+			}
+		}
+		pop.Individuals.Sort()
+		de.HallOfFame = mergeHallOfFame(de.HallOfFame, de.HofSize, pop)
+		if de.Logger != nil {
+			go pop.Log(de.Logger)
+		}
+	}
+}
+
+// mutate builds a DE/rand/1/bin trial vector for the individual at index
+// target: a mutant a + F*(b-c) is formed from three other distinct
+// individuals, then binomially crossed with the target under rate CR, with
+// at least one gene guaranteed to come from the mutant.
+func (de *DiffEvo) mutate(target int, pop Individuals) Individual {
+	var (
+		trial   = MakeIndividual(de.MakeGenome(de.rng))
+		genes   = trial.Genome.CastFloat()
+		x       = pop[target].Genome.CastFloat()
+		a, b, c = de.pickThree(target, len(pop))
+		va      = pop[a].Genome.CastFloat()
+		vb      = pop[b].Genome.CastFloat()
+		vc      = pop[c].Genome.CastFloat()
+		jRand   = de.rng.Intn(len(genes))
+	)
+	for j := range genes {
+		if j == jRand || de.rng.Float64() < de.CR {
+			genes[j] = va[j] + de.F*(vb[j]-vc[j])
+		} else {
+			genes[j] = x[j]
+		}
+	}
+	return trial
+}
+
+// pickThree draws three distinct indices different from target.
+func (de *DiffEvo) pickThree(target, n int) (a, b, c int) {
+	a, b, c = -1, -1, -1
+	for _, i := range de.rng.Perm(n) {
+		if i == target {
+			continue
+		}
+		switch {
+		case a == -1:
+			a = i
+		case b == -1:
+			b = i
+		case c == -1:
+			c = i
+		}
+		if c != -1 {
+			break
+		}
+	}
+	return
+}
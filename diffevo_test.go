@@ -0,0 +1,50 @@
+package gago
+
+import (
+	"math"
+	"testing"
+)
+
+func TestDiffEvoValidate(t *testing.T) {
+	if err := (DiffEvo{Topology: Topology{NPopulations: 1, NIndividuals: 10}, HofSize: 1}).Validate(); err == nil {
+		t.Error("expected an error for a nil GenomeMaker")
+	}
+	var de = DiffEvo{
+		MakeGenome: makeFloatGenomeMaker(4),
+		Topology:   Topology{NPopulations: 1, NIndividuals: minDEIndividuals - 1},
+		HofSize:    1,
+	}
+	if err := de.Validate(); err == nil {
+		t.Error("expected an error for fewer than minDEIndividuals individuals")
+	}
+}
+
+func TestDiffEvoInitializeAndEnhance(t *testing.T) {
+	var de = DiffEvo{
+		MakeGenome: makeFloatGenomeMaker(4),
+		Topology:   Topology{NPopulations: 1, NIndividuals: 10},
+		F:          0.5,
+		CR:         0.9,
+		HofSize:    3,
+	}
+	if err := de.Validate(); err != nil {
+		t.Fatalf("Validate failed: %v", err)
+	}
+	de.Initialize()
+	if len(de.Populations) != 1 || len(de.Populations[0].Individuals) != 10 {
+		t.Fatalf("expected 1 population of 10 individuals, got %d populations", len(de.Populations))
+	}
+	if len(de.HallOfFame) == 0 {
+		t.Fatal("expected a non-empty hall of fame after Initialize")
+	}
+	var best = de.HallOfFame[0].Fitness
+	for i := 0; i < 20; i++ {
+		de.Enhance()
+		if math.IsNaN(de.HallOfFame[0].Fitness) {
+			t.Fatalf("hall of fame fitness is NaN after %d generations", i+1)
+		}
+	}
+	if de.HallOfFame[0].Fitness > best {
+		t.Errorf("expected the hall of fame to not regress, went from %v to %v", best, de.HallOfFame[0].Fitness)
+	}
+}
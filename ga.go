@@ -11,7 +11,6 @@ import (
 // A Topology holds all the information relative to the size of a GA.
 type Topology struct {
 	NPopulations int // Number of populations
-	NSpecies     int // Number of species each population is split into
 	NIndividuals int // Initial number of individuals in each population
 }
 
@@ -20,9 +19,6 @@ func (topo Topology) Validate() error {
 	if topo.NPopulations < 1 {
 		return errors.New("'NPopulations' should be higher or equal to 1")
 	}
-	if topo.NSpecies < 0 {
-		return errors.New("'NSpecies' should be higher or equal to 1 if provided")
-	}
 	if topo.NIndividuals < 1 {
 		return errors.New("'NIndividuals' should be higher or equal to 1")
 	}
@@ -35,13 +31,20 @@ type GA struct {
 	MakeGenome   GenomeMaker
 	Topology     Topology
 	Model        Model
+	Speciator    Speciator
 	Migrator     Migrator
-	MigFrequency int // Frequency at which migrations occur
+	MigFrequency int               // Frequency at which migrations occur
+	HofSize      int               // Number of individuals kept in the hall of fame
+	NGenerations uint              // Number of generations Minimize should run for
+	Callback     func(ga *GA)      // Invoked after every generation
+	EarlyStop    func(ga *GA) bool // Halts evolution early when it returns true
+	ParallelEval bool              // Whether to evaluate individuals concurrently
+	Polisher     Polisher          // Optional local refinement pass run once Minimize terminates
 	Logger       *log.Logger
 
 	// Fields that are generated at runtime
 	Populations Populations
-	Best        Individual // Overall best individual (dummy initialization at the beginning)
+	HallOfFame  Individuals // Best individuals ever seen, sorted by increasing fitness
 	Age         time.Duration
 	Generations int
 	rng         *rand.Rand
@@ -68,24 +71,37 @@ func (ga GA) Validate() error {
 	if modelErr != nil {
 		return modelErr
 	}
+	// Check the speciator, if any, is itself valid
+	if ga.Speciator != nil {
+		if specErr := ga.Speciator.Validate(); specErr != nil {
+			return specErr
+		}
+	}
 	// Check the migration frequency in the presence of a migrator
 	if ga.Migrator != nil && ga.MigFrequency < 1 {
 		return errors.New("'MigFrequency' should be strictly higher than 0")
 	}
+	// Check the hall of fame size
+	if ga.HofSize < 1 {
+		return errors.New("'HofSize' should be higher or equal to 1")
+	}
+	// Check the polisher, if any, is itself valid
+	if ga.Polisher != nil {
+		if polisherErr := ga.Polisher.Validate(); polisherErr != nil {
+			return polisherErr
+		}
+	}
 	// No error
 	return nil
 }
 
-// Find the best individual in each population and then compare the best overall
-// individual to the current best individual. This method supposes that the
-// populations have been preemptively sorted by fitness incresingly; this way
-// checking the first individual of each population is sufficient.
-func (ga *GA) findBest() {
+// updateHallOfFame merges the best individuals of each population into the
+// GA's hall of fame. This method supposes that the populations have been
+// preemptively sorted by fitness increasingly; this way only the sorted head
+// of each population needs to be considered.
+func (ga *GA) updateHallOfFame() {
 	for _, pop := range ga.Populations {
-		var best = pop.Individuals[0]
-		if best.Fitness < ga.Best.Fitness {
-			ga.Best = best
-		}
+		ga.HallOfFame = mergeHallOfFame(ga.HallOfFame, ga.HofSize, pop)
 	}
 }
 
@@ -103,7 +119,11 @@ func (ga *GA) Initialize() {
 			// Generate a population
 			ga.Populations[j] = makePopulation(ga.Topology.NIndividuals, ga.MakeGenome, j)
 			// Evaluate it's individuals
-			ga.Populations[j].Individuals.Evaluate()
+			if ga.ParallelEval {
+				ga.Populations[j].Individuals.EvaluateParallel()
+			} else {
+				ga.Populations[j].Individuals.Evaluate()
+			}
 			// Sort it's individuals
 			ga.Populations[j].Individuals.Sort()
 			// Log current statistics if a logger has been provided
@@ -113,9 +133,9 @@ func (ga *GA) Initialize() {
 		}(i)
 	}
 	wg.Wait()
-	// The initial best individual is initialized randomly
-	ga.Best = MakeIndividual(ga.MakeGenome(makeRandomNumberGenerator()))
-	ga.findBest()
+	// Seed the hall of fame with the best individuals of each population
+	ga.HallOfFame = make(Individuals, 0, ga.HofSize)
+	ga.updateHallOfFame()
 }
 
 // Enhance each population in the GA. The population level operations are done
@@ -129,6 +149,13 @@ func (ga *GA) Enhance() {
 	// generation count
 	if ga.Topology.NPopulations > 1 && ga.Migrator != nil && ga.Generations%ga.MigFrequency == 0 {
 		ga.Migrator.Apply(ga.Populations, ga.rng)
+		// Migration overwrites a population's worst individuals with
+		// immigrants in place without re-sorting; FitnessSpeciator (and
+		// anything else downstream) assumes increasing-fitness order, so
+		// restore it before speciation runs.
+		for i := range ga.Populations {
+			ga.Populations[i].Individuals.Sort()
+		}
 	}
 	// Use a wait group to enhance the populations in parallel
 	var wg sync.WaitGroup
@@ -136,21 +163,34 @@ func (ga *GA) Enhance() {
 		wg.Add(1)
 		go func(j int) {
 			defer wg.Done()
-			// Apply speciation if a positive number of species has been speficied
-			if ga.Topology.NSpecies > 0 {
-				var species = ga.Populations[j].speciate(ga.Topology.NSpecies)
-				// Apply the evolution model to each cluster
-				for k := range species {
-					ga.Model.Apply(&species[k])
+			// Apply speciation if a Speciator has been provided
+			if ga.Speciator != nil {
+				species, err := ga.Speciator.Apply(ga.Populations[j].Individuals, ga.rng)
+				// Speciator errors stem from static misconfiguration (e.g. a
+				// non-positive K) that should have been caught before the GA
+				// started running; panic rather than threading an error
+				// through Enhance's signature.
+				if err != nil {
+					panic(err)
 				}
-				// Merge each cluster back into the original population
-				ga.Populations[j].Individuals = species.merge()
+				// Apply the evolution model to each species
+				var merged = make(Individuals, 0, len(ga.Populations[j].Individuals))
+				for _, indis := range species {
+					var pop = Population{Individuals: indis}
+					ga.Model.Apply(&pop)
+					merged = append(merged, pop.Individuals...)
+				}
+				ga.Populations[j].Individuals = merged
 			} else {
 				// Else apply the evolution model to the entire population
 				ga.Model.Apply(&ga.Populations[j])
 			}
 			// Evaluate and sort
-			ga.Populations[j].Individuals.Evaluate()
+			if ga.ParallelEval {
+				ga.Populations[j].Individuals.EvaluateParallel()
+			} else {
+				ga.Populations[j].Individuals.Evaluate()
+			}
 			ga.Populations[j].Individuals.Sort()
 			ga.Populations[j].Age += time.Since(start)
 			ga.Populations[j].Generations++
@@ -161,7 +201,7 @@ func (ga *GA) Enhance() {
 		}(i)
 	}
 	wg.Wait()
-	// Check if there is an individual that is better than the current one
-	ga.findBest()
+	// Merge the best individuals of each population into the hall of fame
+	ga.updateHallOfFame()
 	ga.Age += time.Since(start)
 }
@@ -0,0 +1,42 @@
+package gago
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// gaState mirrors the scalar fields of a GA that can be round-tripped
+// through JSON. Populations and HallOfFame are deliberately left out:
+// Genome is a user-defined interface, and encoding/json has no way to
+// recover an individual's concrete genome type on Unmarshal, so only the
+// generation count and age - the state that is tedious, not impossible, to
+// recompute - is checkpointed here. Resuming a run means re-running
+// Initialize and replaying Enhance Generations times, or keeping your own
+// population snapshot in whatever concrete format your Genome supports.
+type gaState struct {
+	Generations int           `json:"generations"`
+	Age         time.Duration `json:"age"`
+}
+
+// MarshalJSON checkpoints the scalar state of a GA (generation count and
+// age) so that it can be restored with UnmarshalJSON.
+func (ga GA) MarshalJSON() ([]byte, error) {
+	return json.Marshal(gaState{
+		Generations: ga.Generations,
+		Age:         ga.Age,
+	})
+}
+
+// UnmarshalJSON restores a checkpointed GA's scalar state. The caller is
+// expected to have already set MakeGenome, Topology, Model and the other
+// configuration fields, since those cannot be recovered from JSON; nor can
+// Populations or HallOfFame, which are not part of the serialized state.
+func (ga *GA) UnmarshalJSON(data []byte) error {
+	var state gaState
+	if err := json.Unmarshal(data, &state); err != nil {
+		return err
+	}
+	ga.Generations = state.Generations
+	ga.Age = state.Age
+	return nil
+}
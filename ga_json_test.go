@@ -0,0 +1,27 @@
+package gago
+
+import (
+	"testing"
+	"time"
+)
+
+func TestGAJSONRoundTrip(t *testing.T) {
+	var ga = GA{
+		Generations: 7,
+		Age:         42 * time.Second,
+	}
+	data, err := ga.MarshalJSON()
+	if err != nil {
+		t.Fatalf("MarshalJSON failed: %v", err)
+	}
+	var restored GA
+	if err := restored.UnmarshalJSON(data); err != nil {
+		t.Fatalf("UnmarshalJSON failed: %v", err)
+	}
+	if restored.Generations != ga.Generations {
+		t.Errorf("expected Generations %d, got %d", ga.Generations, restored.Generations)
+	}
+	if restored.Age != ga.Age {
+		t.Errorf("expected Age %v, got %v", ga.Age, restored.Age)
+	}
+}
@@ -0,0 +1,36 @@
+package gago
+
+import "sort"
+
+// mergeHallOfFame inserts the best individuals of pop into hof via a
+// binary-search insertion, keeping hof sorted by increasing fitness and
+// bounded to size entries. Individuals are cloned on insertion so that later
+// mutation of a population's genomes cannot corrupt the hall of fame. Genome
+// exposes no generic equality check, so two genuinely distinct individuals
+// that happen to share the same fitness - common on noisy or discretized
+// objectives - can't be told apart from the same elite winning repeatedly;
+// no de-duplication is attempted, so an elite may end up occupying more than
+// one slot rather than risk silently dropping a distinct optimum. This
+// helper is shared by every Optimizer implementation so they all track the
+// best individuals ever seen the same way.
+func mergeHallOfFame(hof Individuals, size int, pop Population) Individuals {
+	var k = size
+	if k > len(pop.Individuals) {
+		k = len(pop.Individuals)
+	}
+	for _, indi := range pop.Individuals[:k] {
+		var i = sort.Search(len(hof), func(i int) bool {
+			return indi.Fitness < hof[i].Fitness
+		})
+		if i >= size {
+			continue
+		}
+		hof = append(hof, Individual{})
+		copy(hof[i+1:], hof[i:])
+		hof[i] = Individual{Genome: indi.Genome.Clone(), Fitness: indi.Fitness}
+		if len(hof) > size {
+			hof = hof[:size]
+		}
+	}
+	return hof
+}
@@ -0,0 +1,58 @@
+package gago
+
+import "math/rand"
+
+// RingMigrator arranges populations in a ring and, on every Apply call,
+// moves the NMigrants best individuals of population i to population
+// (i+1) % len(pops), displacing the receiving population's worst
+// individuals. This mirrors the classical island-model topology where good
+// genes propagate around a ring at a speed controlled by MigFrequency: a low
+// MigFrequency combined with a high NMigrants leads to fast propagation,
+// while a high MigFrequency (and/or a low NMigrants) favours long isolation
+// and encourages speciation between islands.
+type RingMigrator struct {
+	NMigrants int
+}
+
+// Apply moves the top NMigrants individuals of each population to its
+// neighbour in the ring, replacing the neighbour's worst individuals.
+// Populations are assumed to be sorted by increasing fitness, as is the case
+// after GA's and DiffEvo's Enhance have evaluated and sorted them. PSO's
+// Enhance keeps particles in index order, so under PSO the migrants are the
+// first NMigrants particles and the displaced ones the last NMigrants,
+// whatever their fitness.
+func (mig RingMigrator) Apply(pops Populations, rng *rand.Rand) {
+	var n = len(pops)
+	if n < 2 {
+		return
+	}
+	// Collect the migrants of each population before mutating any of them,
+	// since every population is both a sender and a receiver in a ring.
+	var migrants = make([]Individuals, n)
+	for i, pop := range pops {
+		var k = mig.NMigrants
+		if k > len(pop.Individuals) {
+			k = len(pop.Individuals)
+		}
+		migrants[i] = make(Individuals, k)
+		for j, indi := range pop.Individuals[:k] {
+			migrants[i][j] = Individual{Genome: indi.Genome.Clone(), Fitness: indi.Fitness}
+		}
+	}
+	for i := range pops {
+		var (
+			receiver    = (i + 1) % n
+			k           = len(migrants[i])
+			individuals = pops[receiver].Individuals
+		)
+		if k == 0 || k > len(individuals) {
+			continue
+		}
+		// The displaced individuals are discarded in favour of the migrants;
+		// give their genomes a chance to recycle any pooled resources.
+		for _, indi := range individuals[len(individuals)-k:] {
+			Recycle(indi.Genome)
+		}
+		copy(individuals[len(individuals)-k:], migrants[i])
+	}
+}
@@ -0,0 +1,45 @@
+package gago
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+)
+
+func TestRingMigratorApply(t *testing.T) {
+	var (
+		source    = rand.NewSource(time.Now().UnixNano())
+		generator = rand.New(source)
+		nPops     = 4
+		pops      = make(Populations, nPops)
+		mig       = RingMigrator{NMigrants: 1}
+	)
+	// Build populations sorted by increasing fitness, with a single elite
+	// individual starting out on population 0.
+	for i := range pops {
+		pops[i].Individuals = make(Individuals, 3)
+		for j := range pops[i].Individuals {
+			pops[i].Individuals[j] = makeIndividual(4)
+			pops[i].Individuals[j].Fitness = float64(i*10 + j + 1)
+		}
+	}
+	pops[0].Individuals[0].Fitness = -1
+
+	var visited = map[int]bool{0: true}
+	for i := 0; i < nPops; i++ {
+		mig.Apply(pops, generator)
+		for p := range pops {
+			pops[p].Individuals.Sort()
+			for _, indi := range pops[p].Individuals {
+				if indi.Fitness == -1 {
+					visited[p] = true
+				}
+			}
+		}
+	}
+	for i := 0; i < nPops; i++ {
+		if !visited[i] {
+			t.Errorf("elite individual never visited population %d", i)
+		}
+	}
+}
@@ -0,0 +1,30 @@
+package gago
+
+// Minimize sets newGenome as the GA's GenomeMaker, runs Initialize and then
+// loops Enhance up to NGenerations times. EarlyStop, if set, is checked
+// before every Enhance call and halts evolution as soon as it returns true;
+// Callback, if set, is invoked after Initialize and after every Enhance
+// call. This turns the common "for i := 0; i < N; i++ { ga.Enhance() }"
+// pattern into a first-class driver, letting callers implement
+// fitness-plateau termination, wall-clock limits, or progress bars without
+// rewriting the loop. Once evolution terminates, if a Polisher has been
+// provided it locally refines the best individual in the hall of fame.
+func (ga *GA) Minimize(newGenome GenomeMaker) {
+	ga.MakeGenome = newGenome
+	ga.Initialize()
+	if ga.Callback != nil {
+		ga.Callback(ga)
+	}
+	for i := uint(0); i < ga.NGenerations; i++ {
+		if ga.EarlyStop != nil && ga.EarlyStop(ga) {
+			break
+		}
+		ga.Enhance()
+		if ga.Callback != nil {
+			ga.Callback(ga)
+		}
+	}
+	if ga.Polisher != nil && len(ga.HallOfFame) > 0 {
+		ga.HallOfFame[0] = ga.Polisher.Polish(ga.HallOfFame[0], ga.MakeGenome, ga.rng)
+	}
+}
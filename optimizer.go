@@ -0,0 +1,10 @@
+package gago
+
+// An Optimizer drives an evolutionary search: Initialize sets up its initial
+// populations, and Enhance advances them by one generation. GA, DiffEvo, and
+// PSO all implement it. The only built-in driver loop is GA.Minimize, which
+// works on a GA rather than on an Optimizer.
+type Optimizer interface {
+	Initialize()
+	Enhance()
+}
@@ -0,0 +1,59 @@
+package gago
+
+import (
+	"runtime"
+	"sync"
+)
+
+// A GenomeRecycler is implemented by genomes that hold onto resources -
+// typically backing slices - that are expensive to reallocate. Close is
+// called through Recycle once an individual carrying the genome has been
+// discarded: when RingMigrator displaces it, or when DiffEvo's greedy
+// selection drops it or a losing trial. The GA's own selection and crossover
+// do not call it unless the Model does. This gives the genome a chance to
+// return its resources to a sync.Pool instead of letting them be garbage
+// collected; MakeGenome implementations can then draw from the same pool
+// instead of allocating from scratch. This matters most for real-valued
+// vectors of length 100+, where allocating a fresh slice per offspring per
+// generation puts real pressure on the garbage collector.
+type GenomeRecycler interface {
+	Close()
+}
+
+// Recycle gives a discarded genome a chance to return its resources to a
+// pool if it implements GenomeRecycler. It is a no-op for genomes that don't
+// hold recyclable resources. The GA's own selection and crossover happen
+// inside the user-supplied Model, which is where individuals are actually
+// replaced each generation; Model implementations - built-in or
+// user-defined - should call Recycle on every genome they discard so that
+// pooling benefits the GA itself, not just Migrator and DiffEvo.
+func Recycle(genome Genome) {
+	if recycler, ok := genome.(GenomeRecycler); ok {
+		recycler.Close()
+	}
+}
+
+// EvaluateParallel evaluates each individual's fitness concurrently with a
+// worker pool sized to runtime.NumCPU(). It behaves like Evaluate, except it
+// trades a small amount of scheduling overhead for parallelism, which pays
+// off once fitness evaluation itself is non-trivial.
+func (indis Individuals) EvaluateParallel() {
+	var (
+		jobs = make(chan int, len(indis))
+		wg   sync.WaitGroup
+	)
+	for w := 0; w < runtime.NumCPU(); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				indis[i].Fitness = indis[i].Genome.Evaluate()
+			}
+		}()
+	}
+	for i := range indis {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+}
@@ -0,0 +1,179 @@
+package gago
+
+import (
+	"errors"
+	"log"
+	"math/rand"
+	"sync"
+)
+
+// PSO implements standard particle swarm optimization. Each particle tracks
+// a position (its genome), a velocity, and its personal best; on every
+// Enhance call the velocity is updated as
+// v <- Omega*v + C1*r1*(pbest-x) + C2*r2*(gbest-x) and the position as
+// x <- x+v, with r1 and r2 drawn uniformly from [0,1). Like DiffEvo, it
+// operates on float vectors via CastFloat and reuses GA's Genome/Individual/
+// Population machinery, so it benefits from the same ParallelEval, Migrator,
+// and Logger infrastructure.
+type PSO struct {
+	// Fields that are provided by the user
+	MakeGenome    GenomeMaker
+	Topology      Topology
+	Omega, C1, C2 float64
+	Migrator      Migrator
+	MigFrequency  int
+	ParallelEval  bool
+	HofSize       int
+	Logger        *log.Logger
+
+	// Fields that are generated at runtime
+	Populations Populations
+	HallOfFame  Individuals
+	Generations int
+	rng         *rand.Rand
+
+	velocities   [][][]float64 // [population][particle][dimension]
+	personalBest []Individuals // [population][particle]
+	globalBest   Individual
+}
+
+// Initialize generates each population, evaluates it, and seeds every
+// particle's personal best and velocity.
+func (pso *PSO) Initialize() {
+	pso.Populations = make(Populations, pso.Topology.NPopulations)
+	pso.rng = makeRandomNumberGenerator()
+	pso.velocities = make([][][]float64, pso.Topology.NPopulations)
+	pso.personalBest = make([]Individuals, pso.Topology.NPopulations)
+	pso.HallOfFame = make(Individuals, 0, pso.HofSize)
+	for i := range pso.Populations {
+		pso.Populations[i] = makePopulation(pso.Topology.NIndividuals, pso.MakeGenome, i)
+		pso.Populations[i].Individuals.Evaluate()
+		pso.Populations[i].Individuals.Sort()
+		pso.velocities[i] = make([][]float64, len(pso.Populations[i].Individuals))
+		pso.personalBest[i] = make(Individuals, len(pso.Populations[i].Individuals))
+		for j, indi := range pso.Populations[i].Individuals {
+			pso.velocities[i][j] = make([]float64, len(indi.Genome.CastFloat()))
+			pso.personalBest[i][j] = Individual{Genome: indi.Genome.Clone(), Fitness: indi.Fitness}
+		}
+		pso.HallOfFame = mergeHallOfFame(pso.HallOfFame, pso.HofSize, pso.Populations[i])
+	}
+	if len(pso.HallOfFame) > 0 {
+		pso.globalBest = pso.HallOfFame[0]
+	}
+}
+
+// Validate the parameters of a PSO to ensure it will run correctly.
+func (pso PSO) Validate() error {
+	if pso.MakeGenome == nil {
+		return errors.New("'GenomeMaker' cannot be nil")
+	}
+	if err := pso.Topology.Validate(); err != nil {
+		return err
+	}
+	if pso.Migrator != nil && pso.MigFrequency < 1 {
+		return errors.New("'MigFrequency' should be strictly higher than 0")
+	}
+	if pso.HofSize < 1 {
+		return errors.New("'HofSize' should be higher or equal to 1")
+	}
+	return nil
+}
+
+// Enhance moves every particle according to the standard PSO velocity and
+// position update rules, refreshes personal and global bests, and migrates
+// particles between populations if a Migrator is set.
+func (pso *PSO) Enhance() {
+	pso.Generations++
+	if pso.Topology.NPopulations > 1 && pso.Migrator != nil && pso.Generations%pso.MigFrequency == 0 {
+		var identities = make([][]*float64, len(pso.Populations))
+		for p, pop := range pso.Populations {
+			identities[p] = make([]*float64, len(pop.Individuals))
+			for i, indi := range pop.Individuals {
+				identities[p][i] = genomeIdentity(indi.Genome)
+			}
+		}
+		pso.Migrator.Apply(pso.Populations, pso.rng)
+		// A Migrator overwrites individuals in place without pso's knowledge,
+		// so any slot now holding a genome it didn't hold before the call was
+		// handed a new particle, paired with a stale velocity and personal
+		// best left over from whichever particle it displaced; reset both,
+		// the same way Initialize seeds them for a freshly generated
+		// particle. Genome identity is used rather than fitness equality,
+		// since distinct particles can tie on fitness.
+		for p, pop := range pso.Populations {
+			for i, indi := range pop.Individuals {
+				if genomeIdentity(indi.Genome) == identities[p][i] {
+					continue
+				}
+				pso.velocities[p][i] = make([]float64, len(indi.Genome.CastFloat()))
+				pso.personalBest[p][i] = Individual{Genome: indi.Genome.Clone(), Fitness: indi.Fitness}
+			}
+		}
+	}
+	for p := range pso.Populations {
+		var pop = pso.Populations[p]
+		if pso.ParallelEval {
+			var wg sync.WaitGroup
+			for i := range pop.Individuals {
+				wg.Add(1)
+				go func(i int) {
+					defer wg.Done()
+					pso.updateParticle(p, i)
+				}(i)
+			}
+			wg.Wait()
+		} else {
+			for i := range pop.Individuals {
+				pso.updateParticle(p, i)
+			}
+		}
+		// Particles have identity in PSO: velocities and personal bests are
+		// paired with pop.Individuals by index, so sort a throwaway copy for
+		// the hall of fame instead of reordering the live population.
+		var sorted = append(Individuals{}, pop.Individuals...)
+		sorted.Sort()
+		pso.HallOfFame = mergeHallOfFame(pso.HallOfFame, pso.HofSize, Population{Individuals: sorted})
+		if pso.Logger != nil {
+			go pop.Log(pso.Logger)
+		}
+	}
+	if len(pso.HallOfFame) > 0 && pso.HallOfFame[0].Fitness < pso.globalBest.Fitness {
+		pso.globalBest = pso.HallOfFame[0]
+	}
+}
+
+// updateParticle advances the velocity and position of particle i in
+// population p, then refreshes its personal best if it improved.
+func (pso *PSO) updateParticle(p, i int) {
+	var (
+		indi  = &pso.Populations[p].Individuals[i]
+		x     = indi.Genome.CastFloat()
+		pbest = pso.personalBest[p][i].Genome.CastFloat()
+		gbest = pso.globalBest.Genome.CastFloat()
+		v     = pso.velocities[p][i]
+	)
+	for d := range x {
+		var r1, r2 = pso.rng.Float64(), pso.rng.Float64()
+		v[d] = pso.Omega*v[d] + pso.C1*r1*(pbest[d]-x[d]) + pso.C2*r2*(gbest[d]-x[d])
+		x[d] += v[d]
+	}
+	indi.Fitness = indi.Genome.Evaluate()
+	if indi.Fitness < pso.personalBest[p][i].Fitness {
+		pso.personalBest[p][i] = Individual{Genome: indi.Genome.Clone(), Fitness: indi.Fitness}
+	}
+}
+
+// genomeIdentity returns a pointer that uniquely identifies genome's
+// backing storage, so two Genome values can be compared for identity
+// without relying on Genome itself being comparable. updateParticle
+// mutates a particle's position through the slice CastFloat returns, so
+// the same particle keeps the same backing array across generations;
+// only a genome substituted wholesale - e.g. by a Migrator - gets a new
+// one.
+func genomeIdentity(genome Genome) *float64 {
+	var x = genome.CastFloat()
+	if len(x) == 0 {
+		return nil
+	}
+	return &x[0]
+}
@@ -0,0 +1,85 @@
+package gago
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+	"time"
+)
+
+func TestPSOValidate(t *testing.T) {
+	if err := (PSO{Topology: Topology{NPopulations: 1, NIndividuals: 10}, HofSize: 1}).Validate(); err == nil {
+		t.Error("expected an error for a nil GenomeMaker")
+	}
+}
+
+func TestPSOInitializeAndEnhance(t *testing.T) {
+	var pso = PSO{
+		MakeGenome: makeFloatGenomeMaker(4),
+		Topology:   Topology{NPopulations: 1, NIndividuals: 10},
+		Omega:      0.5,
+		C1:         1,
+		C2:         1,
+		HofSize:    3,
+	}
+	if err := pso.Validate(); err != nil {
+		t.Fatalf("Validate failed: %v", err)
+	}
+	pso.Initialize()
+	if len(pso.Populations) != 1 || len(pso.Populations[0].Individuals) != 10 {
+		t.Fatalf("expected 1 population of 10 individuals, got %d populations", len(pso.Populations))
+	}
+	if len(pso.HallOfFame) == 0 {
+		t.Fatal("expected a non-empty hall of fame after Initialize")
+	}
+	var best = pso.HallOfFame[0].Fitness
+	for i := 0; i < 20; i++ {
+		pso.Enhance()
+		if math.IsNaN(pso.HallOfFame[0].Fitness) {
+			t.Fatalf("hall of fame fitness is NaN after %d generations", i+1)
+		}
+	}
+	if pso.HallOfFame[0].Fitness > best {
+		t.Errorf("expected the hall of fame to not regress, went from %v to %v", best, pso.HallOfFame[0].Fitness)
+	}
+}
+
+func TestPSOEnhanceWithMigration(t *testing.T) {
+	var pso = PSO{
+		MakeGenome:   makeFloatGenomeMaker(4),
+		Topology:     Topology{NPopulations: 2, NIndividuals: 5},
+		Omega:        0.5,
+		C1:           1,
+		C2:           1,
+		HofSize:      3,
+		Migrator:     RingMigrator{NMigrants: 1},
+		MigFrequency: 1,
+	}
+	pso.Initialize()
+	for i := 0; i < 10; i++ {
+		pso.Enhance()
+		for p := range pso.Populations {
+			for _, indi := range pso.Populations[p].Individuals {
+				if math.IsNaN(indi.Fitness) {
+					t.Fatalf("individual fitness is NaN after migration at generation %d", i+1)
+				}
+			}
+		}
+	}
+}
+
+func TestGenomeIdentity(t *testing.T) {
+	var (
+		source    = rand.NewSource(time.Now().UnixNano())
+		generator = rand.New(source)
+		maker     = makeFloatGenomeMaker(4)
+		a         = maker(generator)
+		b         = maker(generator)
+	)
+	if genomeIdentity(a) != genomeIdentity(a) {
+		t.Error("expected the same genome to have a stable identity")
+	}
+	if genomeIdentity(a) == genomeIdentity(b) {
+		t.Error("expected two distinct genomes to have distinct identities")
+	}
+}
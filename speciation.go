@@ -0,0 +1,192 @@
+package gago
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+)
+
+// A Speciator splits a population's individuals into distinct species so
+// that the Model can be applied to each one independently before they are
+// merged back together. This decouples the speciation strategy from
+// Enhance, so that NEAT-style compatibility-distance speciation,
+// phenotype-based speciation, or fitness-sharing schemes can be plugged in
+// without touching the GA's core loop.
+type Speciator interface {
+	Apply(indis Individuals, rng *rand.Rand) ([]Individuals, error)
+	Validate() error
+}
+
+// KMedoidsSpeciator splits individuals into K species with a k-medoids
+// clustering pass over their fitness, generalizing the GA's original
+// hard-coded behaviour.
+type KMedoidsSpeciator struct {
+	K int
+}
+
+// Validate the parameters of a KMedoidsSpeciator to ensure it will run
+// correctly.
+func (spec KMedoidsSpeciator) Validate() error {
+	if spec.K < 1 {
+		return errors.New("'K' should be higher or equal to 1")
+	}
+	return nil
+}
+
+// Apply clusters indis into K species using k-medoids.
+func (spec KMedoidsSpeciator) Apply(indis Individuals, rng *rand.Rand) ([]Individuals, error) {
+	if spec.K < 1 {
+		return nil, errors.New("'K' should be higher or equal to 1")
+	}
+	return dropEmpty(kMedoids(indis, spec.K, rng)), nil
+}
+
+// FitnessSpeciator splits individuals into K species by carving up their
+// fitness-sorted order into consecutive quantiles of len(indis)/K
+// individuals, the last one also taking the remainder. It assumes
+// indis is already sorted by increasing fitness, as is the case inside
+// Enhance.
+type FitnessSpeciator struct {
+	K int
+}
+
+// Validate the parameters of a FitnessSpeciator to ensure it will run
+// correctly.
+func (spec FitnessSpeciator) Validate() error {
+	if spec.K < 1 {
+		return errors.New("'K' should be higher or equal to 1")
+	}
+	return nil
+}
+
+// Apply buckets indis into K fitness quantiles of len(indis)/K individuals
+// each, the last quantile also taking the remainder. K is capped to
+// len(indis) so that no quantile ever comes out empty.
+func (spec FitnessSpeciator) Apply(indis Individuals, rng *rand.Rand) ([]Individuals, error) {
+	if spec.K < 1 {
+		return nil, errors.New("'K' should be higher or equal to 1")
+	}
+	var k = spec.K
+	if k > len(indis) {
+		k = len(indis)
+	}
+	var (
+		species = make([]Individuals, k)
+		size    = len(indis) / k
+	)
+	for i := range species {
+		var start, end = i * size, (i + 1) * size
+		if i == k-1 {
+			end = len(indis)
+		}
+		species[i] = indis[start:end]
+	}
+	return species, nil
+}
+
+// LabelSpeciator delegates speciation to a user-supplied labeler function,
+// grouping individuals that receive the same integer label into the same
+// species. This allows plugging in phenotype-based or domain-specific
+// speciation schemes.
+type LabelSpeciator struct {
+	Label func(Genome) int
+}
+
+// Validate the parameters of a LabelSpeciator to ensure it will run
+// correctly.
+func (spec LabelSpeciator) Validate() error {
+	if spec.Label == nil {
+		return errors.New("'Label' cannot be nil")
+	}
+	return nil
+}
+
+// Apply groups indis by the label their genome is assigned.
+func (spec LabelSpeciator) Apply(indis Individuals, rng *rand.Rand) ([]Individuals, error) {
+	if spec.Label == nil {
+		return nil, errors.New("'Label' cannot be nil")
+	}
+	var groups = make(map[int]Individuals)
+	for _, indi := range indis {
+		var label = spec.Label(indi.Genome)
+		groups[label] = append(groups[label], indi)
+	}
+	var species = make([]Individuals, 0, len(groups))
+	for _, group := range groups {
+		species = append(species, group)
+	}
+	return species, nil
+}
+
+// dropEmpty filters out empty species in place, since an empty set of
+// individuals would make Model.Apply panic trying to select from nothing.
+// kMedoids can produce one when two medoids tie on fitness and the earlier
+// one claims every point of the later one's intended cluster.
+func dropEmpty(species []Individuals) []Individuals {
+	var nonEmpty = species[:0]
+	for _, indis := range species {
+		if len(indis) > 0 {
+			nonEmpty = append(nonEmpty, indis)
+		}
+	}
+	return nonEmpty
+}
+
+// kMedoids clusters indis into k groups based on the distance between their
+// fitnesses, using Lloyd's algorithm adapted to medoids (cluster centers are
+// restricted to being one of the individuals instead of an average).
+func kMedoids(indis Individuals, k int, rng *rand.Rand) []Individuals {
+	if k > len(indis) {
+		k = len(indis)
+	}
+	var (
+		medoids    = rng.Perm(len(indis))[:k]
+		assignment = make([]int, len(indis))
+	)
+	for iter := 0; iter < 10; iter++ {
+		var changed = false
+		// Assign each individual to its closest medoid
+		for i, indi := range indis {
+			var closest, closestDist = 0, math.Inf(1)
+			for m, medoid := range medoids {
+				var dist = math.Abs(indi.Fitness - indis[medoid].Fitness)
+				if dist < closestDist {
+					closest, closestDist = m, dist
+				}
+			}
+			if assignment[i] != closest {
+				assignment[i] = closest
+				changed = true
+			}
+		}
+		if !changed && iter > 0 {
+			break
+		}
+		// Recompute each medoid as the individual minimizing the total
+		// distance to the rest of its cluster
+		for m := range medoids {
+			var cluster []int
+			for i, a := range assignment {
+				if a == m {
+					cluster = append(cluster, i)
+				}
+			}
+			var bestIdx, bestCost = medoids[m], math.Inf(1)
+			for _, i := range cluster {
+				var cost float64
+				for _, j := range cluster {
+					cost += math.Abs(indis[i].Fitness - indis[j].Fitness)
+				}
+				if cost < bestCost {
+					bestIdx, bestCost = i, cost
+				}
+			}
+			medoids[m] = bestIdx
+		}
+	}
+	var species = make([]Individuals, k)
+	for i, a := range assignment {
+		species[a] = append(species[a], indis[i])
+	}
+	return species
+}
@@ -0,0 +1,85 @@
+package gago
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+)
+
+func TestKMedoidsSpeciatorApply(t *testing.T) {
+	var (
+		source    = rand.NewSource(time.Now().UnixNano())
+		generator = rand.New(source)
+		indis     = make(Individuals, 6)
+	)
+	for i := range indis {
+		indis[i] = makeIndividual(4)
+		indis[i].Fitness = float64(i)
+	}
+	var species, err = KMedoidsSpeciator{K: 2}.Apply(indis, generator)
+	if err != nil {
+		t.Fatalf("Apply failed: %v", err)
+	}
+	var total int
+	for _, s := range species {
+		total += len(s)
+	}
+	if total != len(indis) {
+		t.Errorf("expected %d individuals spread across species, got %d", len(indis), total)
+	}
+}
+
+func TestKMedoidsSpeciatorValidate(t *testing.T) {
+	if err := (KMedoidsSpeciator{K: 0}).Validate(); err == nil {
+		t.Error("expected an error for a non-positive K")
+	}
+	if err := (KMedoidsSpeciator{K: 1}).Validate(); err != nil {
+		t.Errorf("did not expect an error, got %v", err)
+	}
+}
+
+func TestFitnessSpeciatorApply(t *testing.T) {
+	var indis = make(Individuals, 6)
+	for i := range indis {
+		indis[i] = makeIndividual(4)
+		indis[i].Fitness = float64(i)
+	}
+	var species, err = FitnessSpeciator{K: 3}.Apply(indis, nil)
+	if err != nil {
+		t.Fatalf("Apply failed: %v", err)
+	}
+	if len(species) != 3 {
+		t.Fatalf("expected 3 species, got %d", len(species))
+	}
+	for _, s := range species {
+		if len(s) != 2 {
+			t.Errorf("expected 2 individuals per species, got %d", len(s))
+		}
+	}
+}
+
+func TestFitnessSpeciatorValidate(t *testing.T) {
+	if err := (FitnessSpeciator{K: 0}).Validate(); err == nil {
+		t.Error("expected an error for a non-positive K")
+	}
+}
+
+func TestLabelSpeciatorApply(t *testing.T) {
+	var indis = make(Individuals, 4)
+	for i := range indis {
+		indis[i] = makeIndividual(4)
+	}
+	var species, err = LabelSpeciator{Label: func(Genome) int { return 0 }}.Apply(indis, nil)
+	if err != nil {
+		t.Fatalf("Apply failed: %v", err)
+	}
+	if len(species) != 1 || len(species[0]) != len(indis) {
+		t.Error("expected a single species holding every individual")
+	}
+}
+
+func TestLabelSpeciatorValidate(t *testing.T) {
+	if err := (LabelSpeciator{}).Validate(); err == nil {
+		t.Error("expected an error for a nil Label")
+	}
+}
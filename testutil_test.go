@@ -0,0 +1,15 @@
+package gago
+
+import "math/rand"
+
+// makeFloatGenomeMaker returns a GenomeMaker producing genomes of n genes
+// drawn uniformly from [-5, 5), for exercising DiffEvo, PSO, and CMAES
+// against a real CastFloat-backed genome instead of a bare stub.
+func makeFloatGenomeMaker(n int) GenomeMaker {
+	return func(rng *rand.Rand) Genome {
+		var indi = makeIndividual(n)
+		var init = InitUniformF{-5, 5}
+		init.apply(&indi, rng)
+		return indi.Genome
+	}
+}